@@ -1,11 +1,10 @@
 package handlers
 
 import (
-	"bytes"
+	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
-	"math"
+	"math/big"
 	"net/http"
 	"strconv"
 	"strings"
@@ -13,21 +12,62 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// Constants for API base URL and endpoints
+// Beacon API endpoint paths
 const (
-	nodeURL             = "https://radial-misty-butterfly.quiknode.pro/d71f751e03f2b6466202f2561941b6c1c0defd13"
-	blockEndpoint       = "/eth/v2/beacon/blocks/%d"
-	validatorEndpoint   = "/eth/v1/beacon/states/head/validators/%d"
-	validatorsEndpoint  = "/eth/v1/beacon/states/head/validators"
-	BaseRewardFactor    = 64
-	EthToGwei           = 1e9            // Conversion factor from ETH to Gwei
-	MaxEffectiveBalance = 32 * EthToGwei // 32 ETH in Gwei
+	blockEndpoint        = "/eth/v2/beacon/blocks/%d"
+	blockRewardsEndpoint = "/eth/v1/beacon/rewards/blocks/%d"
+	GweiToWei            = 1e9 // Conversion factor from Gwei to Wei
 )
 
 // BlockRewardResponse defines the structure of the response for the block reward endpoint
 type BlockRewardResponse struct {
 	Status string `json:"status"`
-	Reward string `json:"reward"`
+	// ConsensusReward is the attestation/sync-committee/slashing reward (in
+	// Gwei) reported by the beacon node's own rewards API, rather than a
+	// locally re-derived approximation.
+	ConsensusReward string `json:"consensus_reward"`
+	// ExecutionTips is the priority-fee income (in Gwei) the fee recipient
+	// collected for a vanilla block. Mutually exclusive with MEVPayment.
+	ExecutionTips string `json:"execution_tips,omitempty"`
+	// MEVPayment is the builder-to-proposer payment (in Gwei) for a block
+	// built via an MEV relay. Mutually exclusive with ExecutionTips.
+	MEVPayment string `json:"mev_payment,omitempty"`
+	// DepositsGwei is the total amount (in Gwei) carried by any EIP-6110 deposit
+	// requests in the block. Deposits are validator balance top-ups, not proposer
+	// income, so they're reported separately from the reward breakdown above.
+	// Omitted for pre-Prague blocks, which have no deposit requests.
+	DepositsGwei string `json:"deposits_gwei,omitempty"`
+}
+
+// BlockRewardsResponse represents the beacon node's block rewards breakdown
+// (all values in Gwei) for GET /eth/v1/beacon/rewards/blocks/{slot}.
+type BlockRewardsResponse struct {
+	Data struct {
+		ProposerIndex     string `json:"proposer_index"`
+		Total             string `json:"total"`
+		Attestations      string `json:"attestations"`
+		SyncAggregate     string `json:"sync_aggregate"`
+		ProposerSlashings string `json:"proposer_slashings"`
+		AttesterSlashings string `json:"attester_slashings"`
+	} `json:"data"`
+}
+
+// DepositRequest represents a single EIP-6110 deposit request carried by the
+// execution payload (post-Prague). Unlike pre-Prague deposits, these are
+// surfaced directly by the execution layer instead of being scraped from the
+// deposit contract's logs.
+type DepositRequest struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	Signature             string `json:"signature"`
+	Index                 string `json:"index"`
+}
+
+// DepositsResponse defines the structure of the response for the deposits endpoint
+type DepositsResponse struct {
+	Slot     string           `json:"slot"`
+	Deposits []DepositRequest `json:"deposits"`
 }
 
 // BlockData represents the structure for block data returned by the beacon node
@@ -49,30 +89,16 @@ type BlockData struct {
 					} `json:"withdrawals"`
 					ExtraData string `json:"extra_data"`
 					LogsBloom string `json:"logs_bloom"`
+					// DepositRequests is absent on pre-Prague blocks and present
+					// (possibly empty) from Prague onward; always decode it as
+					// optional so older blocks don't fail to parse.
+					DepositRequests []DepositRequest `json:"deposit_requests"`
 				} `json:"execution_payload"`
 			} `json:"body"`
 		} `json:"message"`
 	} `json:"data"`
 }
 
-// ValidatorResponse represents the structure for the validator's effective balance
-type ValidatorResponse struct {
-	Data struct {
-		Validator struct {
-			EffectiveBalance string `json:"effective_balance"`
-		} `json:"validator"`
-	} `json:"data"`
-}
-
-// ValidatorsResponse represents the structure for the total ETH staked in the network
-type ValidatorsResponse struct {
-	Data []struct {
-		Validator struct {
-			EffectiveBalance string `json:"effective_balance"`
-		} `json:"validator"`
-	} `json:"data"`
-}
-
 // RPCRequest represents the structure for the JSON-RPC request
 type RPCRequest struct {
 	JSONRPC string        `json:"jsonrpc"`
@@ -81,110 +107,94 @@ type RPCRequest struct {
 	ID      int           `json:"id"`
 }
 
-// RPCResponse represents the structure for the JSON-RPC response
-type RPCResponse struct {
-	JSONRPC string                 `json:"jsonrpc"`
-	ID      int                    `json:"id"`
-	Result  map[string]interface{} `json:"result"`
-}
-
-// Transaction represents a simplified transaction structure
-type Transaction struct {
-	GasPrice             string `json:"gasPrice"`
-	MaxPriorityFeePerGas string `json:"maxPriorityFeePerGas"`
-	GasUsed              string `json:"gas"`
+// BalanceRPCResponse represents the JSON-RPC response for eth_getBalance,
+// whose result is a single hex-encoded Wei amount rather than an object.
+type BalanceRPCResponse struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Result  string `json:"result"`
 }
 
 // FetchBlockData retrieves block data for a given slot
-func FetchBlockData(slot int) (*BlockData, error) {
-	resp, err := http.Get(fmt.Sprintf(nodeURL+blockEndpoint, slot))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to fetch block data, status code: %d", resp.StatusCode)
-	}
-
+func (c *Client) FetchBlockData(ctx context.Context, slot int) (*BlockData, error) {
 	var blockData BlockData
-	if err := json.NewDecoder(resp.Body).Decode(&blockData); err != nil {
-		return nil, err
+	if err := c.beaconGet(ctx, &blockData, blockEndpoint, slot); err != nil {
+		return nil, fmt.Errorf("failed to fetch block data: %w", err)
 	}
-
 	return &blockData, nil
 }
 
-// FetchValidatorBalance fetches the effective balance of the validator
-func FetchValidatorBalance(proposerIndex int) (float64, error) {
-	url := fmt.Sprintf(nodeURL+validatorEndpoint, proposerIndex)
-	resp, err := http.Get(url)
-	if err != nil {
-		return 0, err
+// FetchBlockRewards retrieves the beacon node's own reward breakdown for a
+// slot (attestations, sync aggregate, and slashings, all in Gwei) instead of
+// re-deriving the consensus reward locally.
+func (c *Client) FetchBlockRewards(ctx context.Context, slot int) (*BlockRewardsResponse, error) {
+	var rewards BlockRewardsResponse
+	if err := c.beaconGet(ctx, &rewards, blockRewardsEndpoint, slot); err != nil {
+		return nil, fmt.Errorf("failed to fetch block rewards: %w", err)
 	}
-	defer resp.Body.Close()
+	return &rewards, nil
+}
 
-	var validator ValidatorResponse
-	if err := json.NewDecoder(resp.Body).Decode(&validator); err != nil {
-		return 0, err
+// FetchBalance retrieves the Wei balance of address as of blockNumber via
+// eth_getBalance. The result is returned as a *big.Int rather than a float64:
+// real mainnet fee recipients (MEV builders, staking pools) routinely hold
+// balances well beyond what fits in a uint64/float64 without losing
+// precision.
+func (c *Client) FetchBalance(ctx context.Context, address string, blockNumber int64) (*big.Int, error) {
+	rpcRequest := RPCRequest{
+		JSONRPC: "2.0",
+		Method:  "eth_getBalance",
+		Params:  []interface{}{address, fmt.Sprintf("0x%x", blockNumber)},
+		ID:      1,
 	}
 
-	effectiveBalance, err := strconv.ParseFloat(validator.Data.Validator.EffectiveBalance, 64)
-	if err != nil {
-		return 0, err
+	var rpcResponse BalanceRPCResponse
+	if err := c.executionRPC(ctx, rpcRequest, &rpcResponse); err != nil {
+		return nil, err
 	}
 
-	return effectiveBalance, nil
+	return HexToBigInt(rpcResponse.Result)
 }
 
-// FetchTotalStaked fetches the total ETH staked in the network
-func FetchTotalStaked() (float64, error) {
-	resp, err := http.Get(nodeURL + validatorsEndpoint)
-	if err != nil {
-		return 0, err
-	}
-	defer resp.Body.Close()
-
-	var validatorsResp ValidatorsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&validatorsResp); err != nil {
-		return 0, err
-	}
-
-	totalStaked := 0.0
-	for _, validator := range validatorsResp.Data {
-		balance, err := strconv.ParseFloat(validator.Validator.EffectiveBalance, 64)
+// CoinbaseBalanceDeltaGwei computes the balance delta (in Gwei) of
+// feeRecipient between blockNumber-1 and blockNumber. For a block's fee
+// recipient this delta is the true payment it received for proposing the
+// block: priority-fee tips for a vanilla block, or the builder's payment for
+// an MEV-relay block. Unlike re-deriving the payment from individual
+// transactions, it holds regardless of whether that payment arrived as an
+// aggregated tip or a single direct transfer.
+//
+// This is an approximation, not an exact accounting: it's the recipient's
+// whole balance delta, so it also absorbs any other change to its balance in
+// the same block (the recipient's own outgoing transactions, unrelated
+// inbound transfers), rather than netting those non-tx credits out. Such
+// noise can even push the raw delta negative, which can never be a real
+// payment, so a negative result is clamped to zero.
+func (c *Client) CoinbaseBalanceDeltaGwei(ctx context.Context, feeRecipient string, blockNumber int64) (float64, error) {
+	// There's no block before the genesis block to diff against; treat its
+	// pre-block balance as zero rather than querying the invalid "-1" tag.
+	before := big.NewInt(0)
+	if blockNumber > 0 {
+		var err error
+		before, err = c.FetchBalance(ctx, feeRecipient, blockNumber-1)
 		if err != nil {
-			return 0, err
+			return 0, fmt.Errorf("failed to fetch pre-block balance: %w", err)
 		}
-		totalStaked += balance
-	}
-
-	return totalStaked, nil
-}
-
-// CalculateBaseReward calculates the base reward for a validator
-func CalculateBaseReward(proposerIndex int) (float64, error) {
-	// Fetch the effective balance of the validator
-	effectiveBalance, err := FetchValidatorBalance(proposerIndex)
-	if err != nil {
-		return 0, fmt.Errorf("failed to fetch validator balance: %v", err)
 	}
 
-	// Fetch the total staked ETH in the network
-	totalStaked, err := FetchTotalStaked()
+	after, err := c.FetchBalance(ctx, feeRecipient, blockNumber)
 	if err != nil {
-		return 0, fmt.Errorf("failed to fetch total staked: %v", err)
+		return 0, fmt.Errorf("failed to fetch post-block balance: %w", err)
 	}
 
-	// Cap the effective balance at 32 ETH in Gwei
-	if effectiveBalance > MaxEffectiveBalance {
-		effectiveBalance = MaxEffectiveBalance
+	deltaWei := new(big.Int).Sub(after, before)
+	if deltaWei.Sign() < 0 {
+		return 0, nil
 	}
 
-	// Calculate the base reward
-	baseReward := (BaseRewardFactor * effectiveBalance) / math.Sqrt(totalStaked)
-
-	return baseReward, nil
+	deltaGwei := new(big.Float).Quo(new(big.Float).SetInt(deltaWei), big.NewFloat(GweiToWei))
+	result, _ := deltaGwei.Float64()
+	return result, nil
 }
 
 // IsMEVBlock determines if a block was produced using an MEV relay by checking known MEV relay addresses
@@ -203,6 +213,11 @@ func IsMEVBlock(blockData *BlockData) bool {
 	}
 
 	extraData := blockData.Data.Message.Body.ExecutionPayload.ExtraData
+	if len(extraData) < 2 {
+		// No "0x" prefix to strip, which also covers the empty-string case:
+		// extra_data is legitimately empty for some blocks.
+		return false
+	}
 	decodedExtraData, err := hex.DecodeString(extraData[2:])
 	if err != nil {
 		return false
@@ -218,130 +233,46 @@ func IsMEVBlock(blockData *BlockData) bool {
 	return false
 }
 
-// FetchBlockDetails retrieves block details including transactions
-func FetchBlockDetails(blockNumber int64, quickNodeURL string) (*RPCResponse, error) {
-	hexBlockNumber := fmt.Sprintf("0x%x", blockNumber)
-	rpcRequest := RPCRequest{
-		JSONRPC: "2.0",
-		Method:  "eth_getBlockByNumber",
-		Params:  []interface{}{hexBlockNumber, true},
-		ID:      1,
-	}
-
-	jsonData, err := json.Marshal(rpcRequest)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal RPC request: %v", err)
-	}
-
-	resp, err := http.Post(quickNodeURL, "application/json", bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("failed to send RPC request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	var rpcResponse RPCResponse
-	if err := json.NewDecoder(resp.Body).Decode(&rpcResponse); err != nil {
-		return nil, fmt.Errorf("failed to decode RPC response: %v", err)
-	}
-
-	return &rpcResponse, nil
-}
-
-// HexToFloat converts a hexadecimal string to a float64 value
-func HexToFloat(hexStr string) (float64, error) {
-	value, err := strconv.ParseUint(hexStr[2:], 16, 64)
-	if err != nil {
-		return 0, err
+// HexToBigInt converts a "0x"-prefixed hexadecimal string to a big.Int,
+// for values like Wei balances that can exceed what fits in a uint64.
+func HexToBigInt(hexStr string) (*big.Int, error) {
+	trimmed := strings.TrimPrefix(hexStr, "0x")
+	value, ok := new(big.Int).SetString(trimmed, 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid hex string: %q", hexStr)
 	}
-	return float64(value), nil
+	return value, nil
 }
 
-// CalculateProposerPayment calculates the proposer payment from an MEV relay.
-// This function sums up the payments made by transactions that have a maxPriorityFeePerGas
-// higher than the base fee. This difference represents the additional incentive (MEV payment)
-// that goes to the proposer. The assumption here is that transactions with a maxPriorityFeePerGas
-// greater than the base fee are part of the MEV bundle and are paying an additional incentive
-// to the block proposer. We calculate this by summing up the gas fees associated with these
-// transactions, thereby estimating the total MEV payment included in the block.
-func CalculateProposerPayment(blockDetails *RPCResponse) (float64, error) {
-	var proposerPayment float64
-
-	for _, tx := range blockDetails.Result["transactions"].([]interface{}) {
-		transaction := tx.(map[string]interface{})
-
-		// Check if maxPriorityFeePerGas exists
-		maxPriorityFeePerGasStr, ok := transaction["maxPriorityFeePerGas"].(string)
-		if !ok || maxPriorityFeePerGasStr == "" {
-			continue
-		}
-
-		maxPriorityFeePerGas, err := HexToFloat(maxPriorityFeePerGasStr)
-		if err != nil {
-			return 0, fmt.Errorf("failed to parse maxPriorityFeePerGas: %v", err)
-		}
-
-		gasUsedStr, ok := transaction["gas"].(string)
-		if !ok || gasUsedStr == "" {
-			continue
-		}
-
-		gasUsed, err := HexToFloat(gasUsedStr)
-		if err != nil {
-			return 0, fmt.Errorf("failed to parse gas used: %v", err)
-		}
-
-		// Calculate the payment based on gas used and the max priority fee per gas
-		payment := maxPriorityFeePerGas * gasUsed
-		proposerPayment += payment
+// GetBlockReward retrieves the block reward status for a given slot
+func (client *Client) GetBlockReward(c *gin.Context) {
+	slot, err := strconv.Atoi(c.Param("slot"))
+	if err != nil || slot < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid slot number"})
+		return
 	}
 
-	return proposerPayment, nil
-}
-
-// CalculateTransactionFees calculates the total transaction fees for a block
-func CalculateTransactionFees(blockDetails *RPCResponse) (float64, error) {
-	baseFee, err := HexToFloat(blockDetails.Result["baseFeePerGas"].(string))
+	response, err := client.computeBlockReward(c.Request.Context(), slot)
 	if err != nil {
-		return 0, fmt.Errorf("failed to parse base fee per gas: %v", err)
-	}
-
-	var totalFees float64
-
-	for _, tx := range blockDetails.Result["transactions"].([]interface{}) {
-		transaction := tx.(map[string]interface{})
-
-		gasUsed, err := HexToFloat(transaction["gas"].(string))
-		if err != nil {
-			return 0, fmt.Errorf("failed to parse gas used: %v", err)
-		}
-
-		gasPrice, err := HexToFloat(transaction["gasPrice"].(string))
-		if err != nil {
-			return 0, fmt.Errorf("failed to parse gas price: %v", err)
-		}
-
-		tip := gasPrice - baseFee
-
-		totalFees += tip * gasUsed
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
 	}
 
-	return totalFees, nil
+	c.JSON(http.StatusOK, response)
 }
 
-// GetBlockReward retrieves the block reward status for a given slot
-func GetBlockReward(c *gin.Context) {
-	// Parse slot parameter
-	slot, err := strconv.Atoi(c.Param("slot"))
-	if err != nil || slot < 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid slot number"})
-		return
+// computeBlockReward assembles the BlockRewardResponse for slot, serving it
+// from the reward cache when available so that repeated or nearby lookups
+// (e.g. from /blockreward/range) don't re-hit the upstream nodes.
+func (client *Client) computeBlockReward(ctx context.Context, slot int) (*BlockRewardResponse, error) {
+	if cached, ok := client.cache.Get(slot); ok {
+		return cached, nil
 	}
 
 	// Fetch block data for the given slot
-	blockData, err := FetchBlockData(slot)
+	blockData, err := client.FetchBlockData(ctx, slot)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch block data"})
-		return
+		return nil, fmt.Errorf("failed to fetch block data: %w", err)
 	}
 
 	// Determine if the block was produced using an MEV relay
@@ -351,60 +282,90 @@ func GetBlockReward(c *gin.Context) {
 		status = "MEV Relay"
 	}
 
-	// Extract proposer index and calculate base reward
-	proposerIndex, err := strconv.Atoi(blockData.Data.Message.ProposerIndex)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid proposer index"})
-		return
-	}
-
-	baseReward, err := CalculateBaseReward(proposerIndex)
+	// Fetch the consensus-layer reward breakdown directly from the beacon node
+	// rather than re-deriving it from validator balances.
+	rewards, err := client.FetchBlockRewards(ctx, slot)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to calculate base reward: %v", err)})
-		return
+		return nil, fmt.Errorf("failed to fetch block rewards: %w", err)
 	}
 
-	// Initialize total reward with base reward
-	totalReward := baseReward
-
 	blockNumber, err := strconv.ParseInt(blockData.Data.Message.Body.ExecutionPayload.BlockNumber, 10, 64)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid block number"})
-		return
+		return nil, fmt.Errorf("invalid block number: %w", err)
 	}
 
-	// Fetch block details for the given block number
-	blockDetails, err := FetchBlockDetails(blockNumber, nodeURL)
+	// The fee recipient's balance delta across the block is the true payment
+	// it received for proposing it, whether that's aggregated priority-fee
+	// tips (vanilla) or a builder payment (MEV relay).
+	feeRecipient := blockData.Data.Message.Body.ExecutionPayload.FeeRecipient
+	paymentGwei, err := client.CoinbaseBalanceDeltaGwei(ctx, feeRecipient, blockNumber)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to fetch block details: %v", err)})
-		return
+		return nil, fmt.Errorf("failed to compute fee recipient payment: %w", err)
 	}
 
+	response := &BlockRewardResponse{
+		Status:          status,
+		ConsensusReward: rewards.Data.Total,
+	}
 	if isMEV {
-		// For MEV blocks, calculate the proposer payment
-		proposerPayment, err := CalculateProposerPayment(blockDetails)
+		response.MEVPayment = fmt.Sprintf("%.0f", paymentGwei)
+	} else {
+		response.ExecutionTips = fmt.Sprintf("%.0f", paymentGwei)
+	}
+
+	// Deposits are validator balance top-ups carried by the execution payload
+	// (EIP-6110), not proposer income, so they're surfaced separately rather
+	// than added to the reward figure.
+	deposits := blockData.Data.Message.Body.ExecutionPayload.DepositRequests
+	if len(deposits) > 0 {
+		depositsGwei, err := SumDepositAmountGwei(deposits)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to calculate proposer payment: %v", err)})
-			return
+			return nil, fmt.Errorf("failed to sum deposit amounts: %w", err)
 		}
-		totalReward += proposerPayment
-	} else {
-		// For vanilla blocks, calculate the transaction fees and add to total reward
-		transactionFees, err := CalculateTransactionFees(blockDetails)
+		response.DepositsGwei = fmt.Sprintf("%.0f", depositsGwei)
+	}
+
+	client.cache.Put(slot, response)
+	return response, nil
+}
+
+// SumDepositAmountGwei sums the amount (in Gwei) of a block's EIP-6110
+// deposit requests.
+func SumDepositAmountGwei(deposits []DepositRequest) (float64, error) {
+	var total float64
+	for _, d := range deposits {
+		amount, err := strconv.ParseFloat(d.Amount, 64)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("Failed to calculate transaction fees: %v", err)})
-			return
+			return 0, fmt.Errorf("failed to parse deposit amount: %v", err)
 		}
-		totalReward += transactionFees
+		total += amount
+	}
+	return total, nil
+}
+
+// GetDeposits retrieves the EIP-6110 deposit requests carried by a slot's execution payload
+func (client *Client) GetDeposits(c *gin.Context) {
+	// Parse slot parameter
+	slot, err := strconv.Atoi(c.Param("slot"))
+	if err != nil || slot < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid slot number"})
+		return
 	}
 
-	// Convert total reward from Wei to Gwei
-	finalReward := fmt.Sprintf("%.3f", totalReward/1e9)
+	// Fetch block data for the given slot
+	blockData, err := client.FetchBlockData(c.Request.Context(), slot)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch block data"})
+		return
+	}
 
-	response := BlockRewardResponse{
-		Status: status,
-		Reward: finalReward,
+	deposits := blockData.Data.Message.Body.ExecutionPayload.DepositRequests
+	if deposits == nil {
+		deposits = []DepositRequest{}
 	}
 
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, DepositsResponse{
+		Slot:     blockData.Data.Message.Slot,
+		Deposits: deposits,
+	})
 }
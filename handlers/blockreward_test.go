@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// canned beacon block payloads, shaped like GET /eth/v2/beacon/blocks/{slot}.
+const preEIP6110BlockJSON = `{
+	"data": {
+		"message": {
+			"slot": "100",
+			"proposer_index": "1",
+			"body": {
+				"execution_payload": {
+					"fee_recipient": "0xabc",
+					"block_number": "200",
+					"gas_limit": "30000000",
+					"gas_used": "15000000",
+					"base_fee_per_gas": "7",
+					"transactions": [],
+					"withdrawals": [],
+					"extra_data": "0x",
+					"logs_bloom": "0x"
+				}
+			}
+		}
+	}
+}`
+
+const postEIP6110BlockJSONEmpty = `{
+	"data": {
+		"message": {
+			"slot": "200",
+			"proposer_index": "2",
+			"body": {
+				"execution_payload": {
+					"fee_recipient": "0xabc",
+					"block_number": "300",
+					"gas_limit": "30000000",
+					"gas_used": "15000000",
+					"base_fee_per_gas": "7",
+					"transactions": [],
+					"withdrawals": [],
+					"extra_data": "0x",
+					"logs_bloom": "0x",
+					"deposit_requests": []
+				}
+			}
+		}
+	}
+}`
+
+const postEIP6110BlockJSONPopulated = `{
+	"data": {
+		"message": {
+			"slot": "300",
+			"proposer_index": "3",
+			"body": {
+				"execution_payload": {
+					"fee_recipient": "0xabc",
+					"block_number": "400",
+					"gas_limit": "30000000",
+					"gas_used": "15000000",
+					"base_fee_per_gas": "7",
+					"transactions": [],
+					"withdrawals": [],
+					"extra_data": "0x",
+					"logs_bloom": "0x",
+					"deposit_requests": [
+						{
+							"pubkey": "0x1",
+							"withdrawal_credentials": "0x2",
+							"amount": "32000000000",
+							"signature": "0x3",
+							"index": "0"
+						},
+						{
+							"pubkey": "0x4",
+							"withdrawal_credentials": "0x5",
+							"amount": "1000000000",
+							"signature": "0x6",
+							"index": "1"
+						}
+					]
+				}
+			}
+		}
+	}
+}`
+
+func TestBlockData_DepositRequests(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    []DepositRequest
+	}{
+		{
+			name:    "pre-Prague: deposit_requests absent",
+			payload: preEIP6110BlockJSON,
+			want:    nil,
+		},
+		{
+			name:    "post-Prague: deposit_requests present but empty",
+			payload: postEIP6110BlockJSONEmpty,
+			want:    []DepositRequest{},
+		},
+		{
+			name:    "post-Prague: deposit_requests populated",
+			payload: postEIP6110BlockJSONPopulated,
+			want: []DepositRequest{
+				{Pubkey: "0x1", WithdrawalCredentials: "0x2", Amount: "32000000000", Signature: "0x3", Index: "0"},
+				{Pubkey: "0x4", WithdrawalCredentials: "0x5", Amount: "1000000000", Signature: "0x6", Index: "1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var blockData BlockData
+			if err := json.Unmarshal([]byte(tt.payload), &blockData); err != nil {
+				t.Fatalf("failed to decode block data: %v", err)
+			}
+
+			got := blockData.Data.Message.Body.ExecutionPayload.DepositRequests
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d deposit requests, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("deposit %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+			if tt.want == nil && got != nil {
+				t.Errorf("expected nil DepositRequests for pre-Prague block, got %+v", got)
+			}
+		})
+	}
+}
+
+func TestSumDepositAmountGwei(t *testing.T) {
+	tests := []struct {
+		name     string
+		deposits []DepositRequest
+		want     float64
+		wantErr  bool
+	}{
+		{
+			name:     "no deposits",
+			deposits: nil,
+			want:     0,
+		},
+		{
+			name: "multiple deposits",
+			deposits: []DepositRequest{
+				{Amount: "32000000000"},
+				{Amount: "1000000000"},
+			},
+			want: 33000000000,
+		},
+		{
+			name: "invalid amount",
+			deposits: []DepositRequest{
+				{Amount: "not-a-number"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SumDepositAmountGwei(tt.deposits)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetDeposits_EmptyWhenAbsent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	beacon := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(preEIP6110BlockJSON))
+	}))
+	defer beacon.Close()
+
+	client, err := NewClient(ClientConfig{
+		BeaconURLs:    []string{beacon.URL},
+		ExecutionURLs: []string{"http://unused.invalid"},
+	})
+	if err != nil {
+		t.Fatalf("failed to build client: %v", err)
+	}
+
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodGet, "/deposits/100", nil)
+	c.Params = gin.Params{{Key: "slot", Value: "100"}}
+
+	client.GetDeposits(c)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d", recorder.Code, http.StatusOK)
+	}
+
+	var response DepositsResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.Deposits == nil {
+		t.Fatalf("expected deposits to be [], got null")
+	}
+	if len(response.Deposits) != 0 {
+		t.Errorf("expected no deposits, got %+v", response.Deposits)
+	}
+
+	if !jsonHasEmptyArray(t, recorder.Body.Bytes(), "deposits") {
+		t.Errorf("expected %q field to serialize as [], body: %s", "deposits", recorder.Body.String())
+	}
+}
+
+// jsonHasEmptyArray checks that field is present in body and serialized as
+// an empty JSON array, i.e. "[]" rather than "null".
+func jsonHasEmptyArray(t *testing.T, body []byte, field string) bool {
+	t.Helper()
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		t.Fatalf("failed to decode raw response: %v", err)
+	}
+	value, ok := raw[field]
+	if !ok {
+		return false
+	}
+	return string(value) == "[]"
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"container/list"
+	"sync"
+)
+
+// rewardCache is a small in-memory LRU cache for computed block reward
+// responses, keyed by slot. It exists so a client scrubbing back and forth
+// over a slot range (e.g. via /blockreward/range) doesn't re-hit the
+// upstream beacon/execution nodes for slots it has already computed.
+type rewardCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[int]*list.Element
+	order    *list.List
+}
+
+type rewardCacheEntry struct {
+	slot     int
+	response *BlockRewardResponse
+}
+
+func newRewardCache(capacity int) *rewardCache {
+	return &rewardCache{
+		capacity: capacity,
+		items:    make(map[int]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get returns the cached response for slot, recording a cache hit or miss.
+func (c *rewardCache) Get(slot int) (*BlockRewardResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[slot]
+	if !ok {
+		cacheMisses.Inc()
+		return nil, false
+	}
+
+	c.order.MoveToFront(el)
+	cacheHits.Inc()
+	return el.Value.(*rewardCacheEntry).response, true
+}
+
+// Put stores response for slot, evicting the least recently used entry if
+// the cache is over capacity.
+func (c *rewardCache) Put(slot int, response *BlockRewardResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[slot]; ok {
+		el.Value.(*rewardCacheEntry).response = response
+		c.order.MoveToFront(el)
+		return
+	}
+
+	el := c.order.PushFront(&rewardCacheEntry{slot: slot, response: response})
+	c.items[slot] = el
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			delete(c.items, oldest.Value.(*rewardCacheEntry).slot)
+		}
+	}
+}
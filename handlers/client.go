@@ -0,0 +1,295 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Default tuning for Client when a ClientConfig field is left zero-valued.
+const (
+	DefaultRequestTimeout = 10 * time.Second
+	DefaultMaxRetries     = 3
+	DefaultRetryBackoff   = 200 * time.Millisecond
+	DefaultCacheCapacity  = 1024
+)
+
+// ClientConfig configures a Client. BeaconURLs and ExecutionURLs each take a
+// primary endpoint followed by any number of fallbacks; Client fails over
+// across them on error and round-robins between requests to spread load.
+type ClientConfig struct {
+	BeaconURLs     []string
+	ExecutionURLs  []string
+	RequestTimeout time.Duration
+	MaxRetries     int
+	RetryBackoff   time.Duration
+}
+
+// Client is the beacon/execution node client shared by the handlers. It
+// replaces calling http.Get directly against a single hardcoded node: it
+// supports multiple endpoints per API with round-robin failover, per-request
+// timeouts, and retry with exponential backoff on 5xx and network errors.
+type Client struct {
+	beaconURLs     []string
+	executionURLs  []string
+	httpClient     *http.Client
+	requestTimeout time.Duration
+	maxRetries     int
+	retryBackoff   time.Duration
+	beaconIdx      uint64
+	executionIdx   uint64
+	cache          *rewardCache
+}
+
+// NewClient builds a Client from cfg, applying defaults for any zero-valued
+// tuning fields.
+func NewClient(cfg ClientConfig) (*Client, error) {
+	if len(cfg.BeaconURLs) == 0 {
+		return nil, fmt.Errorf("at least one beacon endpoint is required")
+	}
+	if len(cfg.ExecutionURLs) == 0 {
+		return nil, fmt.Errorf("at least one execution endpoint is required")
+	}
+
+	requestTimeout := cfg.RequestTimeout
+	if requestTimeout == 0 {
+		requestTimeout = DefaultRequestTimeout
+	}
+	maxRetries := cfg.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultMaxRetries
+	}
+	retryBackoff := cfg.RetryBackoff
+	if retryBackoff == 0 {
+		retryBackoff = DefaultRetryBackoff
+	}
+
+	return &Client{
+		beaconURLs:     trimAll(cfg.BeaconURLs),
+		executionURLs:  trimAll(cfg.ExecutionURLs),
+		requestTimeout: requestTimeout,
+		maxRetries:     maxRetries,
+		retryBackoff:   retryBackoff,
+		cache:          newRewardCache(DefaultCacheCapacity),
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				MaxIdleConns:        100,
+				MaxIdleConnsPerHost: 10,
+				IdleConnTimeout:     90 * time.Second,
+			},
+		},
+	}, nil
+}
+
+// NewClientFromEnv builds a Client from BEACON_NODE_URLS and
+// EXECUTION_NODE_URLS, each a comma-separated primary+fallback list (the
+// execution endpoint need not be the same node as the beacon endpoint).
+func NewClientFromEnv() (*Client, error) {
+	return NewClient(ClientConfig{
+		BeaconURLs:    splitEnvURLs("BEACON_NODE_URLS"),
+		ExecutionURLs: splitEnvURLs("EXECUTION_NODE_URLS"),
+	})
+}
+
+func splitEnvURLs(key string) []string {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return nil
+	}
+	return trimAll(strings.Split(raw, ","))
+}
+
+func trimAll(urls []string) []string {
+	trimmed := make([]string, 0, len(urls))
+	for _, u := range urls {
+		u = strings.TrimSpace(u)
+		if u != "" {
+			trimmed = append(trimmed, u)
+		}
+	}
+	return trimmed
+}
+
+// beaconGet issues a GET against the beacon endpoints, fanning out across
+// fallbacks and retrying transient errors, and decodes the JSON response
+// body into out.
+func (c *Client) beaconGet(ctx context.Context, out interface{}, pathFmt string, args ...interface{}) error {
+	path := fmt.Sprintf(pathFmt, args...)
+	body, err := c.doWithFailover(ctx, c.beaconURLs, &c.beaconIdx, func(base string) (*http.Request, error) {
+		return http.NewRequest(http.MethodGet, base+path, nil)
+	})
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(body).Decode(out)
+}
+
+// executionRPC issues a JSON-RPC request against the execution endpoints,
+// fanning out across fallbacks and retrying transient errors, and decodes
+// the JSON-RPC response into out.
+func (c *Client) executionRPC(ctx context.Context, rpcRequest RPCRequest, out interface{}) error {
+	jsonData, err := json.Marshal(rpcRequest)
+	if err != nil {
+		return fmt.Errorf("failed to marshal RPC request: %v", err)
+	}
+
+	body, err := c.doWithFailover(ctx, c.executionURLs, &c.executionIdx, func(base string) (*http.Request, error) {
+		req, err := http.NewRequest(http.MethodPost, base, bytes.NewReader(jsonData))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		return req, nil
+	})
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	if err := json.NewDecoder(body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode RPC response: %v", err)
+	}
+	return nil
+}
+
+// beaconGetStream opens a long-lived GET against the beacon endpoints and
+// returns the raw response body, for streaming responses (e.g. the SSE event
+// subscription) that must outlive Client's normal per-request timeout.
+// Unlike beaconGet it does not retry: a dropped stream is surfaced to the
+// caller to reconnect.
+func (c *Client) beaconGetStream(ctx context.Context, path string) (io.ReadCloser, error) {
+	if len(c.beaconURLs) == 0 {
+		return nil, fmt.Errorf("no beacon endpoints configured")
+	}
+
+	start := int(atomic.AddUint64(&c.beaconIdx, 1) % uint64(len(c.beaconURLs)))
+	var lastErr error
+	for i := 0; i < len(c.beaconURLs); i++ {
+		base := c.beaconURLs[(start+i)%len(c.beaconURLs)]
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, base+path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			lastErr = fmt.Errorf("%s: %w", base, err)
+			continue
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			lastErr = fmt.Errorf("%s: %w", base, &StatusError{StatusCode: resp.StatusCode})
+			continue
+		}
+
+		return resp.Body, nil
+	}
+	return nil, fmt.Errorf("all endpoints exhausted: %w", lastErr)
+}
+
+// doWithFailover tries each of urls in round-robin order (so repeated calls
+// spread load across healthy endpoints), retrying each one with exponential
+// backoff before moving on to the next. It returns the response body of the
+// first 200 OK it gets; the caller is responsible for closing it.
+func (c *Client) doWithFailover(ctx context.Context, urls []string, idx *uint64, buildReq func(base string) (*http.Request, error)) (io.ReadCloser, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("no endpoints configured")
+	}
+
+	start := int(atomic.AddUint64(idx, 1) % uint64(len(urls)))
+	var lastErr error
+	for i := 0; i < len(urls); i++ {
+		base := urls[(start+i)%len(urls)]
+		body, err := c.doWithRetry(ctx, func() (*http.Request, error) { return buildReq(base) })
+		if err == nil {
+			return body, nil
+		}
+		lastErr = fmt.Errorf("%s: %w", base, err)
+	}
+	return nil, fmt.Errorf("all endpoints exhausted: %w", lastErr)
+}
+
+// doWithRetry retries a single endpoint on network errors and 5xx responses
+// using exponential backoff, up to c.maxRetries retries after the first
+// attempt.
+func (c *Client) doWithRetry(ctx context.Context, buildReq func() (*http.Request, error)) (io.ReadCloser, error) {
+	backoff := c.retryBackoff
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			backoff *= 2
+		}
+
+		req, err := buildReq()
+		if err != nil {
+			return nil, err
+		}
+
+		reqCtx, cancel := context.WithTimeout(ctx, c.requestTimeout)
+		resp, err := c.httpClient.Do(req.WithContext(reqCtx))
+		if err != nil {
+			cancel()
+			lastErr = err
+			continue
+		}
+
+		if resp.StatusCode >= 500 {
+			resp.Body.Close()
+			cancel()
+			lastErr = &StatusError{StatusCode: resp.StatusCode}
+			continue
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			cancel()
+			return nil, &StatusError{StatusCode: resp.StatusCode}
+		}
+
+		return &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}, nil
+	}
+	return nil, lastErr
+}
+
+// StatusError reports a non-200 response from an upstream endpoint (a
+// non-retryable status, or a 5xx that persisted through every retry),
+// preserving the status code for callers that need to map it
+// (e.g. a 404 meaning "no duties for this slot" vs. a 500).
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("upstream returned status code: %d", e.StatusCode)
+}
+
+// cancelOnCloseBody releases the per-request context when the response body
+// is closed, instead of right after the request completes (which would cut
+// off the caller still reading the body).
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testClientConfig(beaconURLs, executionURLs []string) ClientConfig {
+	return ClientConfig{
+		BeaconURLs:     beaconURLs,
+		ExecutionURLs:  executionURLs,
+		RequestTimeout: time.Second,
+		MaxRetries:     2,
+		RetryBackoff:   time.Millisecond,
+	}
+}
+
+func TestDoWithFailover_RoundRobin(t *testing.T) {
+	var hitsA, hitsB int64
+	serverA := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&hitsA, 1)
+		w.Write([]byte("{}"))
+	}))
+	defer serverA.Close()
+	serverB := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&hitsB, 1)
+		w.Write([]byte("{}"))
+	}))
+	defer serverB.Close()
+
+	client, err := NewClient(testClientConfig([]string{serverA.URL, serverB.URL}, []string{"http://unused.invalid"}))
+	if err != nil {
+		t.Fatalf("failed to build client: %v", err)
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 4; i++ {
+		if err := client.beaconGet(ctx, nil, "/ping"); err != nil {
+			t.Fatalf("request %d failed: %v", i, err)
+		}
+	}
+
+	if hitsA == 0 || hitsB == 0 {
+		t.Fatalf("expected requests spread across both endpoints, got A=%d B=%d", hitsA, hitsB)
+	}
+	if hitsA+hitsB != 4 {
+		t.Fatalf("expected 4 total requests, got %d", hitsA+hitsB)
+	}
+}
+
+func TestDoWithFailover_FailsOverToHealthyEndpoint(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer down.Close()
+
+	var hits int64
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&hits, 1)
+		w.Write([]byte("{}"))
+	}))
+	defer up.Close()
+
+	client, err := NewClient(testClientConfig([]string{down.URL, up.URL}, []string{"http://unused.invalid"}))
+	if err != nil {
+		t.Fatalf("failed to build client: %v", err)
+	}
+
+	if err := client.beaconGet(context.Background(), nil, "/ping"); err != nil {
+		t.Fatalf("expected failover to succeed, got error: %v", err)
+	}
+	if hits == 0 {
+		t.Fatalf("expected the healthy endpoint to have been hit")
+	}
+}
+
+func TestDoWithRetry_RetriesOn5xxThenSucceeds(t *testing.T) {
+	var attempts int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt64(&attempts, 1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(testClientConfig([]string{server.URL}, []string{"http://unused.invalid"}))
+	if err != nil {
+		t.Fatalf("failed to build client: %v", err)
+	}
+
+	if err := client.beaconGet(context.Background(), nil, "/ping"); err != nil {
+		t.Fatalf("expected eventual success after retries, got error: %v", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts (2 failures + 1 success), got %d", attempts)
+	}
+}
+
+func TestDoWithRetry_NoRetryOnNon5xxStatus(t *testing.T) {
+	var attempts int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&attempts, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(testClientConfig([]string{server.URL}, []string{"http://unused.invalid"}))
+	if err != nil {
+		t.Fatalf("failed to build client: %v", err)
+	}
+
+	err = client.beaconGet(context.Background(), nil, "/ping")
+	if err == nil {
+		t.Fatalf("expected an error for a 404 response")
+	}
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("expected a *StatusError, got %T: %v", err, err)
+	}
+	if statusErr.StatusCode != http.StatusNotFound {
+		t.Errorf("got status code %d, want %d", statusErr.StatusCode, http.StatusNotFound)
+	}
+	if attempts != 1 {
+		t.Errorf("expected no retries for a non-5xx status, got %d attempts", attempts)
+	}
+}
+
+func TestFetchBlockData_AgainstFakeBeacon(t *testing.T) {
+	beacon := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(preEIP6110BlockJSON))
+	}))
+	defer beacon.Close()
+
+	client, err := NewClient(testClientConfig([]string{beacon.URL}, []string{"http://unused.invalid"}))
+	if err != nil {
+		t.Fatalf("failed to build client: %v", err)
+	}
+
+	blockData, err := client.FetchBlockData(context.Background(), 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if blockData.Data.Message.Slot != "100" {
+		t.Errorf("got slot %q, want %q", blockData.Data.Message.Slot, "100")
+	}
+}
+
+func TestFetchBalance_AgainstFakeExecution(t *testing.T) {
+	// A balance comfortably above 2^64 Wei (~18.44 ETH), to exercise the
+	// big.Int decoding path.
+	const hugeBalanceHex = "0xDE0B6B3A7640000DE0B6B3A764000" // ~72,057,594,037,927,936 * 1e18 Wei
+
+	execution := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"` + hugeBalanceHex + `"}`))
+	}))
+	defer execution.Close()
+
+	client, err := NewClient(testClientConfig([]string{"http://unused.invalid"}, []string{execution.URL}))
+	if err != nil {
+		t.Fatalf("failed to build client: %v", err)
+	}
+
+	balance, err := client.FetchBalance(context.Background(), "0xabc", 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want, err := HexToBigInt(hugeBalanceHex)
+	if err != nil {
+		t.Fatalf("failed to parse expected balance: %v", err)
+	}
+	if balance.Cmp(want) != 0 {
+		t.Errorf("got balance %s, want %s", balance, want)
+	}
+}
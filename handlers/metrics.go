@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	cacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "blockreward_cache_hits_total",
+		Help: "Number of block reward cache lookups that hit the in-memory LRU cache.",
+	})
+	cacheMisses = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "blockreward_cache_misses_total",
+		Help: "Number of block reward cache lookups that missed the in-memory LRU cache.",
+	})
+)
+
+// Metrics serves the Prometheus metrics exposed by the package, including
+// the block reward cache hit/miss counters.
+func Metrics() gin.HandlerFunc {
+	handler := promhttp.Handler()
+	return func(c *gin.Context) {
+		handler.ServeHTTP(c.Writer, c.Request)
+	}
+}
@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DefaultRangeWorkers is the default size of the worker pool used by
+// RangeBlockRewards when the caller doesn't specify one.
+const DefaultRangeWorkers = 8
+
+// StreamBlockRewards implements GET /blockreward/stream (SSE): it subscribes
+// to the beacon node's head event stream and, for each new head, computes
+// and pushes that slot's BlockRewardResponse to the client as it arrives.
+func (client *Client) StreamBlockRewards(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	heads, errs := client.subscribeHeadEvents(ctx)
+
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case slot, ok := <-heads:
+			if !ok {
+				return false
+			}
+			response, err := client.computeBlockReward(ctx, slot)
+			if err != nil {
+				c.SSEvent("error", gin.H{"slot": slot, "error": err.Error()})
+				return true
+			}
+			c.SSEvent("blockreward", response)
+			return true
+		case err, ok := <-errs:
+			if ok {
+				c.SSEvent("error", gin.H{"error": err.Error()})
+			}
+			return false
+		case <-ctx.Done():
+			return false
+		}
+	})
+}
+
+// subscribeHeadEvents opens the beacon node's SSE event stream (GET
+// /eth/v1/events?topics=head) and decodes each head event into a slot
+// number, emitting one value per new head until ctx is canceled or the
+// stream ends.
+func (client *Client) subscribeHeadEvents(ctx context.Context) (<-chan int, <-chan error) {
+	slots := make(chan int)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(slots)
+
+		body, err := client.beaconGetStream(ctx, "/eth/v1/events?topics=head")
+		if err != nil {
+			errs <- fmt.Errorf("failed to subscribe to head events: %w", err)
+			return
+		}
+		defer body.Close()
+
+		scanner := bufio.NewScanner(body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			data, ok := strings.CutPrefix(line, "data:")
+			if !ok {
+				continue
+			}
+
+			var head struct {
+				Slot string `json:"slot"`
+			}
+			if err := json.Unmarshal([]byte(strings.TrimSpace(data)), &head); err != nil {
+				continue
+			}
+
+			slot, err := strconv.Atoi(head.Slot)
+			if err != nil {
+				continue
+			}
+
+			select {
+			case slots <- slot:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			errs <- fmt.Errorf("head event stream ended: %w", err)
+		}
+	}()
+
+	return slots, errs
+}
+
+// RangeBlockRewards implements GET /blockreward/range?from=&to=&workers=: it
+// computes each slot's BlockRewardResponse concurrently across a bounded
+// worker pool, then streams the results back to the client as
+// newline-delimited JSON in ascending slot order.
+func (client *Client) RangeBlockRewards(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	from, err := strconv.Atoi(c.Query("from"))
+	if err != nil || from < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid 'from' slot"})
+		return
+	}
+
+	to, err := strconv.Atoi(c.Query("to"))
+	if err != nil || to < from {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid 'to' slot"})
+		return
+	}
+
+	workers := DefaultRangeWorkers
+	if raw := c.Query("workers"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid 'workers' count"})
+			return
+		}
+		workers = parsed
+	}
+
+	type rangeResult struct {
+		slot     int
+		response *BlockRewardResponse
+		err      error
+	}
+
+	slots := make(chan int)
+	results := make(chan rangeResult)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for slot := range slots {
+				response, err := client.computeBlockReward(ctx, slot)
+				results <- rangeResult{slot: slot, response: response, err: err}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(slots)
+		for slot := from; slot <= to; slot++ {
+			select {
+			case slots <- slot:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	c.Writer.Header().Set("Content-Type", "application/x-ndjson")
+	c.Status(http.StatusOK)
+
+	// Workers complete out of slot order; buffer each result until the next
+	// slot in sequence is ready so the client sees them in order.
+	pending := make(map[int]rangeResult, workers)
+	next := from
+	for r := range results {
+		pending[r.slot] = r
+		for {
+			res, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+
+			if res.err != nil {
+				fmt.Fprintf(c.Writer, "{\"slot\":%d,\"error\":%q}\n", res.slot, res.err.Error())
+			} else {
+				line, _ := json.Marshal(res.response)
+				c.Writer.Write(append(line, '\n'))
+			}
+			c.Writer.Flush()
+			next++
+		}
+	}
+}
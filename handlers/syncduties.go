@@ -1,8 +1,8 @@
 package handlers
 
 import (
-	"encoding/json"
-	"fmt"
+	"context"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -14,13 +14,7 @@ type SyncDutiesResponse struct {
 }
 
 // GetLatestSlot fetches the latest slot number from the beacon chain
-func GetLatestSlot(nodeURL string) (int, error) {
-	resp, err := http.Get(fmt.Sprintf("%s/eth/v1/beacon/headers", nodeURL))
-	if err != nil {
-		return 0, err
-	}
-	defer resp.Body.Close()
-
+func (c *Client) GetLatestSlot(ctx context.Context) (int, error) {
 	var response struct {
 		Data []struct {
 			Header struct {
@@ -31,7 +25,7 @@ func GetLatestSlot(nodeURL string) (int, error) {
 		} `json:"data"`
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+	if err := c.beaconGet(ctx, &response, "/eth/v1/beacon/headers"); err != nil {
 		return 0, err
 	}
 
@@ -39,15 +33,16 @@ func GetLatestSlot(nodeURL string) (int, error) {
 }
 
 // GetSyncDuties retrieves a list of validators with sync committee duties for a given slot
-func GetSyncDuties(c *gin.Context) {
-	nodeURL := "https://radial-misty-butterfly.quiknode.pro/d71f751e03f2b6466202f2561941b6c1c0defd13"
+func (client *Client) GetSyncDuties(c *gin.Context) {
+	ctx := c.Request.Context()
+
 	requestedSlot, err := strconv.Atoi(c.Param("slot"))
 	if err != nil || requestedSlot < 0 {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid slot number"})
 		return
 	}
 
-	latestSlot, err := GetLatestSlot(nodeURL)
+	latestSlot, err := client.GetLatestSlot(ctx)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch latest slot"})
 		return
@@ -58,27 +53,20 @@ func GetSyncDuties(c *gin.Context) {
 		return
 	}
 
-	resp, err := http.Get(fmt.Sprintf("%s/eth/v1/beacon/states/%d/sync_committees", nodeURL, requestedSlot))
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch data"})
-		return
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		statusMap := map[int]string{
-			http.StatusNotFound:            "Slot not found or no duties available",
-			http.StatusInternalServerError: "Unexpected server error",
-		}
-		c.JSON(resp.StatusCode, gin.H{"error": statusMap[resp.StatusCode]})
-		return
-	}
-
 	var duties struct {
 		Data SyncDutiesResponse `json:"data"`
 	}
-	if err := json.NewDecoder(resp.Body).Decode(&duties); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to parse response"})
+	if err := client.beaconGet(ctx, &duties, "/eth/v1/beacon/states/%d/sync_committees", requestedSlot); err != nil {
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) {
+			statusMap := map[int]string{
+				http.StatusNotFound:            "Slot not found or no duties available",
+				http.StatusInternalServerError: "Unexpected server error",
+			}
+			c.JSON(statusErr.StatusCode, gin.H{"error": statusMap[statusErr.StatusCode]})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch data"})
 		return
 	}
 
@@ -1,19 +1,36 @@
 package main
 
 import (
+	"log"
+
 	"eth-validator-api/handlers"
 
 	"github.com/gin-gonic/gin"
 )
 
 func main() {
+	client, err := handlers.NewClientFromEnv()
+	if err != nil {
+		log.Fatalf("failed to configure beacon/execution client: %v", err)
+	}
+
 	router := gin.Default()
 
 	// Route for block reward
-	router.GET("/blockreward/:slot", handlers.GetBlockReward)
+	router.GET("/blockreward/:slot", client.GetBlockReward)
+
+	// Route for live block reward streaming (SSE) and range queries
+	router.GET("/blockreward/stream", client.StreamBlockRewards)
+	router.GET("/blockreward/range", client.RangeBlockRewards)
+
+	// Route for EIP-6110 deposit requests
+	router.GET("/deposits/:slot", client.GetDeposits)
 
 	// Route for sync duties
-	router.GET("/syncduties/:slot", handlers.GetSyncDuties)
+	router.GET("/syncduties/:slot", client.GetSyncDuties)
+
+	// Prometheus metrics, including block reward cache hit/miss counters
+	router.GET("/metrics", handlers.Metrics())
 
 	// Start the server
 	router.Run(":8080")